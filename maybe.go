@@ -23,8 +23,12 @@ func (m Maybe[T]) HasValue() bool {
 	return m.valid
 }
 
-// Value returns the value of the Maybe. It does not protect against nil, so how can we do that?
-func (m Maybe[T]) Value() T {
+// Get returns the value of the Maybe. It does not protect against nil, so how can we do that?
+//
+// Named Get rather than Value so that Maybe[T] can also implement
+// driver.Valuer's Value() (driver.Value, error) without a method collision;
+// see maybe_sql.go.
+func (m Maybe[T]) Get() T {
 	return m.value
 }
 
@@ -36,6 +40,23 @@ func (m Maybe[T]) ValueOr(v T) T {
 	return v
 }
 
+// Or returns m if it is Just, otherwise returns other.
+func (m Maybe[T]) Or(other Maybe[T]) Maybe[T] {
+	if m.valid {
+		return m
+	}
+	return other
+}
+
+// OrElseFunc returns m if it is Just, otherwise lazily computes and returns
+// the fallback Maybe from f.
+func (m Maybe[T]) OrElseFunc(f func() Maybe[T]) Maybe[T] {
+	if m.valid {
+		return m
+	}
+	return f()
+}
+
 func (m *Maybe[T]) UnmarshalJSON(data []byte) error {
 	var t *T
 	if err := json.Unmarshal(data, &t); err != nil {
@@ -60,15 +81,19 @@ func (m Maybe[T]) MarshalJSON() ([]byte, error) {
 }
 
 // Just is the return operation for Maybe monad that returns the representation
-// of existence of a value.
+// of existence of a value. The value is stored at its declared type T; it is
+// never dereferenced. A nil pointer (or an interface T wrapping a nil
+// concrete pointer) is treated as Nothing, since a nil pointer carries no
+// value to be Just of. A nil slice or map is deliberately NOT treated as
+// Nothing: it is a valid, usable empty value of T, not an absence of one.
 func Just[T any](v T) Maybe[T] {
-	if reflect.ValueOf(v).Kind() == reflect.Ptr {
-		rv := reflect.ValueOf(v)
-		if rv.IsNil() {
-			return Nothing[T]()
-		}
-		x := rv.Elem().Interface().(T)
-		return Maybe[T]{value: x, valid: true}
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		// v is the nil interface value, e.g. Just[any](nil).
+		return Nothing[T]()
+	}
+	if rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return Nothing[T]()
 	}
 	return Maybe[T]{value: v, valid: true}
 }
@@ -82,18 +107,9 @@ func Nothing[T any]() Maybe[T] {
 // From is the return operation for Maybe monad that returns either Just a or
 // Nothing. Intended to be used with Go functions that return tuple as `val, ok`.
 func From[T any](val T, ok ...bool) Maybe[T] {
-	// TOOD: understand this "Ok" logic
 	if len(ok) > 0 && !ok[0] {
 		return Nothing[T]()
 	}
-	if reflect.ValueOf(val).Kind() == reflect.Ptr {
-		rv := reflect.ValueOf(val)
-		if rv.IsNil() {
-			return Nothing[T]()
-		}
-		x := rv.Elem().Interface().(T)
-		return Maybe[T]{value: x, valid: true}
-	}
 	return Just(val)
 }
 
@@ -131,3 +147,96 @@ func Match[A, B any](Nothing func() B, Just func(A) B) func(Maybe[A]) B {
 		return Nothing()
 	}
 }
+
+// CatMaybes takes a slice of Maybe values and returns a slice of all the
+// values contained in the Justs, dropping the Nothings, in order.
+func CatMaybes[T any](ms []Maybe[T]) []T {
+	var out []T
+	for _, m := range ms {
+		if m.valid {
+			out = append(out, m.value)
+		}
+	}
+	return out
+}
+
+// MapMaybe applies f to every element of xs and keeps only the values of the
+// results that are Just, discarding the Nothings.
+func MapMaybe[A, B any](f func(A) Maybe[B], xs []A) []B {
+	var out []B
+	for _, x := range xs {
+		if m := f(x); m.valid {
+			out = append(out, m.value)
+		}
+	}
+	return out
+}
+
+// ListToMaybe returns Nothing if xs is empty, otherwise Just of its first
+// element.
+func ListToMaybe[T any](xs []T) Maybe[T] {
+	if len(xs) == 0 {
+		return Nothing[T]()
+	}
+	return Just(xs[0])
+}
+
+// MaybeToList returns nil for Nothing and a single element slice for Just.
+func MaybeToList[T any](m Maybe[T]) []T {
+	if !m.valid {
+		return nil
+	}
+	return []T{m.value}
+}
+
+// Ap is the applicative "apply" operation. It applies a function wrapped in
+// a Maybe to a value wrapped in a Maybe, returning Just(f(a)) only when both
+// sides are Just.
+func Ap[A, B any](mf Maybe[func(A) B]) func(Maybe[A]) Maybe[B] {
+	return func(m Maybe[A]) Maybe[B] {
+		if mf.valid && m.valid {
+			return Just(mf.value(m.value))
+		}
+		return Nothing[B]()
+	}
+}
+
+// LiftA2 lifts a two-argument function into the Maybe applicative, combining
+// two independent Maybes without nesting Fmap calls.
+func LiftA2[A, B, C any](f func(A, B) C) func(Maybe[A], Maybe[B]) Maybe[C] {
+	return func(ma Maybe[A], mb Maybe[B]) Maybe[C] {
+		if ma.valid && mb.valid {
+			return Just(f(ma.value, mb.value))
+		}
+		return Nothing[C]()
+	}
+}
+
+// LiftA3 lifts a three-argument function into the Maybe applicative, combining
+// three independent Maybes without nesting Fmap calls.
+func LiftA3[A, B, C, D any](f func(A, B, C) D) func(Maybe[A], Maybe[B], Maybe[C]) Maybe[D] {
+	return func(ma Maybe[A], mb Maybe[B], mc Maybe[C]) Maybe[D] {
+		if ma.valid && mb.valid && mc.valid {
+			return Just(f(ma.value, mb.value, mc.value))
+		}
+		return Nothing[D]()
+	}
+}
+
+// Alt returns a if it is Just, otherwise b. It is the package-level
+// equivalent of (Maybe[T]).Or.
+func Alt[T any](a, b Maybe[T]) Maybe[T] {
+	return a.Or(b)
+}
+
+// FirstJust returns the leftmost Just among ms, or Nothing if all are
+// Nothing. Useful for threading multiple optional lookups, e.g. config file
+// -> env var -> default.
+func FirstJust[T any](ms ...Maybe[T]) Maybe[T] {
+	for _, m := range ms {
+		if m.valid {
+			return m
+		}
+	}
+	return Nothing[T]()
+}
@@ -0,0 +1,72 @@
+package mo
+
+import "testing"
+
+func TestMaybeOr(t *testing.T) {
+	if got := Just(1).Or(Just(2)); got.Get() != 1 {
+		t.Fatalf("Just(1).Or(Just(2)) = %+v, want Just(1)", got)
+	}
+	if got := Nothing[int]().Or(Just(2)); !got.HasValue() || got.Get() != 2 {
+		t.Fatalf("Nothing.Or(Just(2)) = %+v, want Just(2)", got)
+	}
+	if got := Nothing[int]().Or(Nothing[int]()); got.HasValue() {
+		t.Fatalf("Nothing.Or(Nothing) = %+v, want Nothing", got)
+	}
+}
+
+func TestAlt(t *testing.T) {
+	if got := Alt(Just(1), Just(2)); got.Get() != 1 {
+		t.Fatalf("Alt(Just(1), Just(2)) = %+v, want Just(1)", got)
+	}
+	if got := Alt(Nothing[int](), Just(2)); !got.HasValue() || got.Get() != 2 {
+		t.Fatalf("Alt(Nothing, Just(2)) = %+v, want Just(2)", got)
+	}
+	if got := Alt(Nothing[int](), Nothing[int]()); got.HasValue() {
+		t.Fatalf("Alt(Nothing, Nothing) = %+v, want Nothing", got)
+	}
+}
+
+func TestFirstJust(t *testing.T) {
+	if got := FirstJust[int](); got.HasValue() {
+		t.Fatalf("FirstJust() = %+v, want Nothing", got)
+	}
+	if got := FirstJust(Nothing[int](), Nothing[int]()); got.HasValue() {
+		t.Fatalf("FirstJust(Nothing, Nothing) = %+v, want Nothing", got)
+	}
+	if got := FirstJust(Nothing[int](), Just(2), Just(3)); !got.HasValue() || got.Get() != 2 {
+		t.Fatalf("FirstJust(Nothing, Just(2), Just(3)) = %+v, want Just(2)", got)
+	}
+	if got := FirstJust(Just(1), Just(2)); got.Get() != 1 {
+		t.Fatalf("FirstJust(Just(1), Just(2)) = %+v, want leftmost Just(1)", got)
+	}
+}
+
+func TestMaybeOrElseFunc(t *testing.T) {
+	t.Run("Just skips the fallback", func(t *testing.T) {
+		called := false
+		got := Just(1).OrElseFunc(func() Maybe[int] {
+			called = true
+			return Just(2)
+		})
+		if got.Get() != 1 {
+			t.Fatalf("Just(1).OrElseFunc(...) = %+v, want Just(1)", got)
+		}
+		if called {
+			t.Fatalf("OrElseFunc fallback was called on a Just, want lazy skip")
+		}
+	})
+
+	t.Run("Nothing invokes the fallback", func(t *testing.T) {
+		called := false
+		got := Nothing[int]().OrElseFunc(func() Maybe[int] {
+			called = true
+			return Just(2)
+		})
+		if !got.HasValue() || got.Get() != 2 {
+			t.Fatalf("Nothing.OrElseFunc(...) = %+v, want Just(2)", got)
+		}
+		if !called {
+			t.Fatalf("OrElseFunc fallback was not called on a Nothing")
+		}
+	})
+}
@@ -0,0 +1,79 @@
+package mo
+
+import "testing"
+
+func TestAp(t *testing.T) {
+	add1 := func(n int) int { return n + 1 }
+
+	t.Run("Just function, Just value", func(t *testing.T) {
+		got := Ap[int, int](Just(add1))(Just(2))
+		if !got.HasValue() || got.Get() != 3 {
+			t.Fatalf("Ap(Just(f))(Just(2)) = %+v, want Just(3)", got)
+		}
+	})
+
+	t.Run("Nothing function, Just value", func(t *testing.T) {
+		got := Ap[int, int](Nothing[func(int) int]())(Just(2))
+		if got.HasValue() {
+			t.Fatalf("Ap(Nothing)(Just(2)) = %+v, want Nothing", got)
+		}
+	})
+
+	t.Run("Just function, Nothing value", func(t *testing.T) {
+		got := Ap[int, int](Just(add1))(Nothing[int]())
+		if got.HasValue() {
+			t.Fatalf("Ap(Just(f))(Nothing) = %+v, want Nothing", got)
+		}
+	})
+
+	t.Run("Nothing function, Nothing value", func(t *testing.T) {
+		got := Ap[int, int](Nothing[func(int) int]())(Nothing[int]())
+		if got.HasValue() {
+			t.Fatalf("Ap(Nothing)(Nothing) = %+v, want Nothing", got)
+		}
+	})
+}
+
+func TestLiftA2(t *testing.T) {
+	add := func(a, b int) int { return a + b }
+	liftedAdd := LiftA2(add)
+
+	if got := liftedAdd(Just(1), Just(2)); !got.HasValue() || got.Get() != 3 {
+		t.Fatalf("LiftA2(add)(Just(1), Just(2)) = %+v, want Just(3)", got)
+	}
+	if got := liftedAdd(Nothing[int](), Just(2)); got.HasValue() {
+		t.Fatalf("LiftA2(add)(Nothing, Just(2)) = %+v, want Nothing", got)
+	}
+	if got := liftedAdd(Just(1), Nothing[int]()); got.HasValue() {
+		t.Fatalf("LiftA2(add)(Just(1), Nothing) = %+v, want Nothing", got)
+	}
+	if got := liftedAdd(Nothing[int](), Nothing[int]()); got.HasValue() {
+		t.Fatalf("LiftA2(add)(Nothing, Nothing) = %+v, want Nothing", got)
+	}
+}
+
+func TestLiftA3(t *testing.T) {
+	sum3 := func(a, b, c int) int { return a + b + c }
+	liftedSum3 := LiftA3(sum3)
+
+	if got := liftedSum3(Just(1), Just(2), Just(3)); !got.HasValue() || got.Get() != 6 {
+		t.Fatalf("LiftA3(sum3)(Just(1), Just(2), Just(3)) = %+v, want Just(6)", got)
+	}
+
+	cases := []struct {
+		name    string
+		a, b, c Maybe[int]
+	}{
+		{name: "Nothing in first position", a: Nothing[int](), b: Just(2), c: Just(3)},
+		{name: "Nothing in middle position", a: Just(1), b: Nothing[int](), c: Just(3)},
+		{name: "Nothing in last position", a: Just(1), b: Just(2), c: Nothing[int]()},
+		{name: "all Nothing", a: Nothing[int](), b: Nothing[int](), c: Nothing[int]()},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := liftedSum3(tt.a, tt.b, tt.c); got.HasValue() {
+				t.Fatalf("LiftA3(sum3)(%+v, %+v, %+v) = %+v, want Nothing", tt.a, tt.b, tt.c, got)
+			}
+		})
+	}
+}
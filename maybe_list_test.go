@@ -0,0 +1,87 @@
+package mo
+
+import "testing"
+
+func intsEqual(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestCatMaybes(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []Maybe[int]
+		want []int
+	}{
+		{name: "empty slice", in: nil, want: nil},
+		{name: "all Nothing", in: []Maybe[int]{Nothing[int](), Nothing[int]()}, want: nil},
+		{name: "all Just", in: []Maybe[int]{Just(1), Just(2), Just(3)}, want: []int{1, 2, 3}},
+		{name: "mixed preserves order", in: []Maybe[int]{Just(1), Nothing[int](), Just(3)}, want: []int{1, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CatMaybes(tt.in)
+			if !intsEqual(got, tt.want) {
+				t.Fatalf("CatMaybes(%v) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapMaybe(t *testing.T) {
+	evenHalf := func(n int) Maybe[int] {
+		if n%2 != 0 {
+			return Nothing[int]()
+		}
+		return Just(n / 2)
+	}
+
+	tests := []struct {
+		name string
+		in   []int
+		want []int
+	}{
+		{name: "empty slice", in: nil, want: nil},
+		{name: "all Nothing", in: []int{1, 3, 5}, want: nil},
+		{name: "all Just", in: []int{2, 4, 6}, want: []int{1, 2, 3}},
+		{name: "mixed preserves order", in: []int{1, 2, 3, 4}, want: []int{1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MapMaybe(evenHalf, tt.in)
+			if !intsEqual(got, tt.want) {
+				t.Fatalf("MapMaybe(...) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListToMaybe(t *testing.T) {
+	if m := ListToMaybe[int](nil); m.HasValue() {
+		t.Fatalf("ListToMaybe(nil) = %+v, want Nothing", m)
+	}
+	if m := ListToMaybe([]int{}); m.HasValue() {
+		t.Fatalf("ListToMaybe([]) = %+v, want Nothing", m)
+	}
+	if m := ListToMaybe([]int{1, 2, 3}); !m.HasValue() || m.Get() != 1 {
+		t.Fatalf("ListToMaybe([1,2,3]) = %+v, want Just(1)", m)
+	}
+}
+
+func TestMaybeToList(t *testing.T) {
+	if got := MaybeToList(Nothing[int]()); got != nil {
+		t.Fatalf("MaybeToList(Nothing) = %v, want nil", got)
+	}
+	if got := MaybeToList(Just(5)); !intsEqual(got, []int{5}) {
+		t.Fatalf("MaybeToList(Just(5)) = %v, want [5]", got)
+	}
+}
@@ -0,0 +1,131 @@
+package mo
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Scan implements the database/sql.Scanner interface, letting Maybe[T] be
+// used directly as a struct field against sql.Rows.Scan, in place of the
+// sql.NullString/sql.NullInt64/etc. family. A nil src scans to Nothing;
+// anything else is converted to T via a direct type assertion, falling back
+// to a reflect-based conversion for the primitive kinds.
+func (m *Maybe[T]) Scan(src any) error {
+	if src == nil {
+		*m = Nothing[T]()
+		return nil
+	}
+
+	if v, ok := src.(T); ok {
+		*m = Just(v)
+		return nil
+	}
+
+	var zero T
+	rt := reflect.TypeOf(zero)
+	if rt == nil {
+		return fmt.Errorf("mo: cannot scan %T into Maybe[%T]", src, zero)
+	}
+
+	if rt == reflect.TypeOf(time.Time{}) {
+		if t, ok := src.(time.Time); ok {
+			*m = Just(any(t).(T))
+			return nil
+		}
+		return fmt.Errorf("mo: cannot scan %T into Maybe[time.Time]", src)
+	}
+
+	sv := reflect.ValueOf(src)
+
+	switch rt.Kind() {
+	case reflect.String:
+		switch s := src.(type) {
+		case string:
+			*m = Just(any(s).(T))
+			return nil
+		case []byte:
+			*m = Just(any(string(s)).(T))
+			return nil
+		}
+	case reflect.Slice:
+		if rt.Elem().Kind() == reflect.Uint8 {
+			switch s := src.(type) {
+			case []byte:
+				*m = Just(any(append([]byte(nil), s...)).(T))
+				return nil
+			case string:
+				*m = Just(any([]byte(s)).(T))
+				return nil
+			}
+		}
+	case reflect.Bool:
+		if b, ok := src.(bool); ok {
+			*m = Just(any(b).(T))
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if sv.CanInt() {
+			nv := reflect.New(rt).Elem()
+			nv.SetInt(sv.Int())
+			*m = Just(nv.Interface().(T))
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if sv.CanInt() {
+			nv := reflect.New(rt).Elem()
+			nv.SetUint(uint64(sv.Int()))
+			*m = Just(nv.Interface().(T))
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		switch sv.Kind() {
+		case reflect.Float32, reflect.Float64:
+			nv := reflect.New(rt).Elem()
+			nv.SetFloat(sv.Float())
+			*m = Just(nv.Interface().(T))
+			return nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			nv := reflect.New(rt).Elem()
+			nv.SetFloat(float64(sv.Int()))
+			*m = Just(nv.Interface().(T))
+			return nil
+		}
+	}
+
+	return fmt.Errorf("mo: cannot scan %T into Maybe[%s]", src, rt)
+}
+
+// Value implements the database/sql/driver.Valuer interface. Nothing marshals
+// to a nil driver.Value; Just(v) is converted to one of the driver's
+// supported types via reflection for the primitive kinds.
+func (m Maybe[T]) Value() (driver.Value, error) {
+	if !m.valid {
+		return nil, nil
+	}
+
+	v := any(m.value)
+	switch vv := v.(type) {
+	case int64, float64, bool, []byte, string, time.Time:
+		return vv, nil
+	case driver.Valuer:
+		return vv.Value()
+	}
+
+	rv := reflect.ValueOf(m.value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Bool:
+		return rv.Bool(), nil
+	}
+
+	return nil, fmt.Errorf("mo: cannot convert Maybe[%T] to driver.Value", m.value)
+}
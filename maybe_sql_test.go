@@ -0,0 +1,163 @@
+package mo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMaybeScan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     any
+		want    Maybe[int]
+		wantErr bool
+	}{
+		{name: "nil scans to Nothing", src: nil, want: Nothing[int]()},
+		{name: "int64 scans to Just", src: int64(42), want: Just(42)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m Maybe[int]
+			err := m.Scan(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scan(%v) error = %v, wantErr %v", tt.src, err, tt.wantErr)
+			}
+			if err == nil && (m.HasValue() != tt.want.HasValue() || m.Get() != tt.want.Get()) {
+				t.Fatalf("Scan(%v) = %+v, want %+v", tt.src, m, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaybeScanString(t *testing.T) {
+	var m Maybe[string]
+	if err := m.Scan([]byte("hello")); err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if !m.HasValue() || m.Get() != "hello" {
+		t.Fatalf("Scan(\"hello\" bytes) = %+v", m)
+	}
+}
+
+func TestMaybeScanTime(t *testing.T) {
+	now := time.Now()
+	var m Maybe[time.Time]
+	if err := m.Scan(now); err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if !m.HasValue() || !m.Get().Equal(now) {
+		t.Fatalf("Scan(time.Time) = %+v, want %v", m, now)
+	}
+}
+
+func TestMaybeScanIncompatible(t *testing.T) {
+	var m Maybe[int]
+	if err := m.Scan("not a number"); err == nil {
+		t.Fatalf("Scan(string) into Maybe[int] = nil error, want error")
+	}
+}
+
+func TestMaybeScanBool(t *testing.T) {
+	var m Maybe[bool]
+	if err := m.Scan(true); err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if !m.HasValue() || m.Get() != true {
+		t.Fatalf("Scan(true) = %+v, want Just(true)", m)
+	}
+}
+
+func TestMaybeScanUint(t *testing.T) {
+	var m Maybe[uint]
+	if err := m.Scan(int64(7)); err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if !m.HasValue() || m.Get() != uint(7) {
+		t.Fatalf("Scan(int64(7)) into Maybe[uint] = %+v, want Just(7)", m)
+	}
+}
+
+func TestMaybeScanFloat(t *testing.T) {
+	var m Maybe[float32]
+	if err := m.Scan(float64(3.5)); err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if !m.HasValue() || m.Get() != float32(3.5) {
+		t.Fatalf("Scan(float64(3.5)) into Maybe[float32] = %+v, want Just(3.5)", m)
+	}
+}
+
+func TestMaybeScanFloatFromInt(t *testing.T) {
+	var m Maybe[float64]
+	if err := m.Scan(int64(9)); err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if !m.HasValue() || m.Get() != float64(9) {
+		t.Fatalf("Scan(int64(9)) into Maybe[float64] = %+v, want Just(9)", m)
+	}
+}
+
+func TestMaybeValue(t *testing.T) {
+	tests := []struct {
+		name string
+		m    Maybe[int]
+		want any
+	}{
+		{name: "Nothing is nil", m: Nothing[int](), want: nil},
+		{name: "Just is its int64", m: Just(7), want: int64(7)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.Value()
+			if err != nil {
+				t.Fatalf("Value() error = %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("Value() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaybeValueString(t *testing.T) {
+	m := Just("hi")
+	got, err := m.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	if got != "hi" {
+		t.Fatalf("Value() = %v, want %q", got, "hi")
+	}
+}
+
+func TestMaybeValueBool(t *testing.T) {
+	got, err := Just(true).Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	if got != true {
+		t.Fatalf("Value() = %v, want true", got)
+	}
+}
+
+func TestMaybeValueFloat(t *testing.T) {
+	got, err := Just(3.14).Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	if got != 3.14 {
+		t.Fatalf("Value() = %v, want 3.14", got)
+	}
+}
+
+func TestMaybeValueUint(t *testing.T) {
+	got, err := Just(uint(9)).Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	if got != int64(9) {
+		t.Fatalf("Value() = %v, want int64(9)", got)
+	}
+}
@@ -0,0 +1,63 @@
+package mo
+
+import "testing"
+
+func TestJustFromPointerHandling(t *testing.T) {
+	x := 5
+
+	t.Run("Just[*int](nil) is Nothing", func(t *testing.T) {
+		m := Just[*int](nil)
+		if m.HasValue() {
+			t.Fatalf("Just[*int](nil) = %+v, want Nothing", m)
+		}
+	})
+
+	t.Run("Just[*int](&x) preserves the pointer", func(t *testing.T) {
+		m := Just(&x)
+		if !m.HasValue() {
+			t.Fatalf("Just(&x) = %+v, want Just", m)
+		}
+		if m.Get() != &x {
+			t.Fatalf("Just(&x).Get() = %p, want %p", m.Get(), &x)
+		}
+	})
+
+	t.Run("Just[any](nil) is Nothing", func(t *testing.T) {
+		m := Just[any](nil)
+		if m.HasValue() {
+			t.Fatalf("Just[any](nil) = %+v, want Nothing", m)
+		}
+	})
+
+	t.Run("Just[any] wrapping a nil *int is Nothing", func(t *testing.T) {
+		var p *int
+		m := Just[any](p)
+		if m.HasValue() {
+			t.Fatalf("Just[any](nil *int) = %+v, want Nothing", m)
+		}
+	})
+
+	t.Run("Just[[]int](nil) is Just of an empty slice, not Nothing", func(t *testing.T) {
+		m := Just[[]int](nil)
+		if !m.HasValue() {
+			t.Fatalf("Just[[]int](nil) = %+v, want Just", m)
+		}
+		if len(m.Get()) != 0 {
+			t.Fatalf("Just[[]int](nil).Get() = %v, want empty", m.Get())
+		}
+	})
+
+	t.Run("From[T](nil, true) mirrors Just", func(t *testing.T) {
+		m := From[*int](nil, true)
+		if m.HasValue() {
+			t.Fatalf("From[*int](nil, true) = %+v, want Nothing", m)
+		}
+	})
+
+	t.Run("From[T](v, false) is Nothing regardless of value", func(t *testing.T) {
+		m := From(&x, false)
+		if m.HasValue() {
+			t.Fatalf("From(&x, false) = %+v, want Nothing", m)
+		}
+	})
+}
@@ -0,0 +1,166 @@
+// Result represents the outcome `Ok` or the failure `Err` of a computation
+// that can fail with a reason, complementing Maybe in the same way Either
+// complements Maybe in Haskell, or Result complements Option in Rust.
+//
+// Inspiration and code taken from:
+// * https://github.com/erikjuhani/go-fp/blob/main/maybe/maybe.go
+// * https://raw.githubusercontent.com/pmorelli92/maybe/main/maybe.go
+package mo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrNothing is the error held by a Result converted from a Nothing Maybe
+// via FromResult, since a Maybe carries no reason for its absence.
+var ErrNothing = errors.New("mo: no value")
+
+// Result monad data type representation. Holds either a value (Ok) or an
+// error (Err), never both.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// IsOk reports whether the Result holds a value.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr reports whether the Result holds an error.
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Value returns the value of the Result. It is the zero value of T when the
+// Result is an Err.
+func (r Result[T]) Value() T {
+	return r.value
+}
+
+// Err returns the error of the Result, or nil when the Result is Ok.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// ValueOr uses the value if the Result is Ok, otherwise uses the passed
+// value.
+func (r Result[T]) ValueOr(v T) T {
+	if r.err == nil {
+		return r.value
+	}
+	return v
+}
+
+// ToMaybe converts a Result to a Maybe, dropping the error.
+func (r Result[T]) ToMaybe() Maybe[T] {
+	if r.err != nil {
+		return Nothing[T]()
+	}
+	return Just(r.value)
+}
+
+// resultJSON is the discriminated wire format for Result, distinguishing Ok
+// from Err so UnmarshalJSON can reconstruct either case. An Ok whose value
+// encodes as JSON null, and an Err whose message is empty, do not round-trip:
+// UnmarshalJSON rejects them as invalid Result JSON.
+type resultJSON[T any] struct {
+	Ok    *T     `json:"ok,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+func (r *Result[T]) UnmarshalJSON(data []byte) error {
+	var w resultJSON[T]
+	if err := json.Unmarshal(data, &w); err != nil {
+		return err
+	}
+
+	if w.Ok != nil {
+		*r = Ok(*w.Ok)
+		return nil
+	}
+	if w.Error != "" {
+		*r = Err[T](errors.New(w.Error))
+		return nil
+	}
+
+	return fmt.Errorf("mo: invalid Result JSON: %s", data)
+}
+
+func (r Result[T]) MarshalJSON() ([]byte, error) {
+	if r.err != nil {
+		return json.Marshal(resultJSON[T]{Error: r.err.Error()})
+	}
+	return json.Marshal(resultJSON[T]{Ok: &r.value})
+}
+
+// Ok is the return operation for Result monad that returns the representation
+// of a successful computation holding v.
+func Ok[T any](v T) Result[T] {
+	return Result[T]{value: v}
+}
+
+// Err is the return operation for Result monad that returns the
+// representation of a failed computation holding err.
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// FromResult builds a Result from a Maybe and an error, for interop with
+// functions that return both. A non-nil err takes precedence over the Maybe.
+func FromResult[T any](m Maybe[T], err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	if m.valid {
+		return Ok(m.value)
+	}
+	return Err[T](ErrNothing)
+}
+
+// Try runs f and wraps its result as an Ok or Err depending on whether it
+// returned a non-nil error.
+func Try[T any](f func() (T, error)) Result[T] {
+	v, err := f()
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(v)
+}
+
+// MapResult takes the contents of the Result monad and passes it to function
+// `f` as a parameter, returning a new Result holding f's return value. An Err
+// Result passes its error through unchanged.
+func MapResult[A, B any](f func(A) B) func(Result[A]) Result[B] {
+	return func(r Result[A]) Result[B] {
+		if r.err != nil {
+			return Err[B](r.err)
+		}
+		return Ok(f(r.value))
+	}
+}
+
+// FlatMapResult lets non-monadic function `f` operate on the contents of
+// Result a, lifting the value to a new domain (Result a -> Result b). An Err
+// Result passes its error through unchanged.
+func FlatMapResult[A, B any](f func(A) Result[B]) func(Result[A]) Result[B] {
+	return func(r Result[A]) Result[B] {
+		if r.err != nil {
+			return Err[B](r.err)
+		}
+		return f(r.value)
+	}
+}
+
+// MatchResult matches Result monad depending on its current state and
+// returns the value determined by the return type of b.
+func MatchResult[A, B any](onErr func(error) B, onOk func(A) B) func(Result[A]) B {
+	return func(r Result[A]) B {
+		if r.err != nil {
+			return onErr(r.err)
+		}
+		return onOk(r.value)
+	}
+}
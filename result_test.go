@@ -0,0 +1,124 @@
+package mo
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestResultOkErr(t *testing.T) {
+	ok := Ok(42)
+	if !ok.IsOk() || ok.IsErr() || ok.Value() != 42 {
+		t.Fatalf("Ok(42) = %+v", ok)
+	}
+
+	wantErr := errors.New("boom")
+	err := Err[int](wantErr)
+	if err.IsOk() || !err.IsErr() || err.Err() != wantErr {
+		t.Fatalf("Err(boom) = %+v", err)
+	}
+}
+
+func TestResultValueOr(t *testing.T) {
+	if got := Ok(1).ValueOr(2); got != 1 {
+		t.Fatalf("ValueOr on Ok = %d, want 1", got)
+	}
+	if got := Err[int](errors.New("x")).ValueOr(2); got != 2 {
+		t.Fatalf("ValueOr on Err = %d, want 2", got)
+	}
+}
+
+func TestResultToMaybe(t *testing.T) {
+	if m := Ok(1).ToMaybe(); !m.HasValue() || m.Get() != 1 {
+		t.Fatalf("Ok(1).ToMaybe() = %+v", m)
+	}
+	if m := Err[int](errors.New("x")).ToMaybe(); m.HasValue() {
+		t.Fatalf("Err(x).ToMaybe() = %+v, want Nothing", m)
+	}
+}
+
+func TestFromResult(t *testing.T) {
+	if r := FromResult(Just(1), nil); !r.IsOk() || r.Value() != 1 {
+		t.Fatalf("FromResult(Just(1), nil) = %+v", r)
+	}
+	wantErr := errors.New("boom")
+	if r := FromResult(Just(1), wantErr); !r.IsErr() || r.Err() != wantErr {
+		t.Fatalf("FromResult(Just(1), boom) = %+v", r)
+	}
+	if r := FromResult(Nothing[int](), nil); !r.IsErr() || r.Err() != ErrNothing {
+		t.Fatalf("FromResult(Nothing, nil) = %+v, want ErrNothing", r)
+	}
+}
+
+func TestTry(t *testing.T) {
+	if r := Try(func() (int, error) { return 1, nil }); !r.IsOk() || r.Value() != 1 {
+		t.Fatalf("Try(ok) = %+v", r)
+	}
+	wantErr := errors.New("boom")
+	if r := Try(func() (int, error) { return 0, wantErr }); !r.IsErr() || r.Err() != wantErr {
+		t.Fatalf("Try(err) = %+v", r)
+	}
+}
+
+func TestMapResultFlatMapResultMatchResult(t *testing.T) {
+	double := MapResult(func(n int) int { return n * 2 })
+	if r := double(Ok(3)); r.Value() != 6 {
+		t.Fatalf("MapResult(Ok(3)) = %+v, want 6", r)
+	}
+	wantErr := errors.New("boom")
+	if r := double(Err[int](wantErr)); !r.IsErr() || r.Err() != wantErr {
+		t.Fatalf("MapResult(Err) = %+v, want err passed through", r)
+	}
+
+	halveIfEven := FlatMapResult(func(n int) Result[int] {
+		if n%2 != 0 {
+			return Err[int](errors.New("odd"))
+		}
+		return Ok(n / 2)
+	})
+	if r := halveIfEven(Ok(4)); r.Value() != 2 {
+		t.Fatalf("FlatMapResult(Ok(4)) = %+v, want 2", r)
+	}
+	if r := halveIfEven(Ok(3)); !r.IsErr() {
+		t.Fatalf("FlatMapResult(Ok(3)) = %+v, want Err", r)
+	}
+
+	describe := MatchResult(
+		func(err error) string { return "err: " + err.Error() },
+		func(n int) string { return "ok" },
+	)
+	if got := describe(Ok(1)); got != "ok" {
+		t.Fatalf("MatchResult(Ok) = %q, want ok", got)
+	}
+	if got := describe(Err[int](errors.New("boom"))); got != "err: boom" {
+		t.Fatalf("MatchResult(Err) = %q", got)
+	}
+}
+
+func TestResultJSONRoundTrip(t *testing.T) {
+	ok := Ok("hello")
+	data, err := json.Marshal(ok)
+	if err != nil {
+		t.Fatalf("Marshal(Ok): %v", err)
+	}
+	var gotOk Result[string]
+	if err := json.Unmarshal(data, &gotOk); err != nil {
+		t.Fatalf("Unmarshal(Ok): %v", err)
+	}
+	if !gotOk.IsOk() || gotOk.Value() != "hello" {
+		t.Fatalf("round-tripped Ok = %+v, want Ok(hello)", gotOk)
+	}
+
+	errResult := Err[string](errors.New("boom"))
+	data, err = json.Marshal(errResult)
+	if err != nil {
+		t.Fatalf("Marshal(Err): %v", err)
+	}
+	var gotErr Result[string]
+	if err := json.Unmarshal(data, &gotErr); err != nil {
+		t.Fatalf("Unmarshal(Err): %v", err)
+	}
+	if !gotErr.IsErr() || gotErr.Err().Error() != "boom" {
+		t.Fatalf("round-tripped Err = %+v, want Err(boom)", gotErr)
+	}
+}